@@ -0,0 +1,54 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/AskiaDev/go-pokedex/internal/poketrainer"
+)
+
+func commandCatch(cfg *config, args ...string) error {
+	if len(args) == 0 {
+		return errors.New("catch command requires a pokemon name. Usage: catch <pokemon_name>")
+	}
+
+	if cfg.trainer.CurrentLocation == nil {
+		return errors.New("explore a location before trying to catch a pokemon there")
+	}
+
+	pokemonName := args[0]
+
+	areaResp, err := cfg.pokeapiClient.GetAreaDetails(cfg.trainer.CurrentLocation.URL)
+	if err != nil {
+		return err
+	}
+
+	encounterable := false
+	for _, encounter := range areaResp.PokemonEncounters {
+		if encounter.Pokemon.Name == pokemonName {
+			encounterable = true
+			break
+		}
+	}
+
+	if !encounterable {
+		return fmt.Errorf("%s is not found in %s", pokemonName, areaResp.Name)
+	}
+
+	pokemon, err := cfg.pokeapiClient.GetPokemon(pokemonName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Throwing a Pokeball at %s...\n", pokemonName)
+
+	if !poketrainer.AttemptCatch(pokemon.BaseExperience) {
+		fmt.Printf("%s escaped!\n", pokemonName)
+		return nil
+	}
+
+	fmt.Printf("%s was caught!\n", pokemonName)
+	cfg.trainer.Pokedex[pokemon.Name] = pokemon
+
+	return nil
+}
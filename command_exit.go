@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/AskiaDev/go-pokedex/internal/pokecache"
+)
+
+func commandExit(cfg *config, args ...string) error {
+	path, err := pokedexPath()
+	if err != nil {
+		fmt.Println("Warning: failed to resolve pokedex path:", err)
+	} else if err := cfg.trainer.Save(path); err != nil {
+		fmt.Println("Warning: failed to save pokedex:", err)
+	}
+
+	path, err = cachePath()
+	if err != nil {
+		fmt.Println("Warning: failed to resolve cache path:", err)
+	} else if err := saveCache(cfg.pokeapiClient.Cache(), path); err != nil {
+		fmt.Println("Warning: failed to save cache:", err)
+	}
+
+	fmt.Println("Closing the Pokedex... Goodbye!")
+	os.Exit(0)
+	return nil
+}
+
+func saveCache(cache *pokecache.Cache, path string) error {
+	data, err := cache.Snapshot()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
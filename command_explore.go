@@ -3,27 +3,34 @@ package main
 import (
 	"errors"
 	"fmt"
+
+	"github.com/AskiaDev/go-pokedex/internal/pokeapi"
 )
 
 func commandExplore(cfg *config, args ...string) error {
+	var location *pokeapi.MapResult
+
 	if len(args) == 0 {
-		return errors.New("explore command requires a location name. Usage: explore <location_name>")
+		if cfg.trainer.CurrentLocation == nil {
+			return errors.New("explore command requires a location name, or visit one first. Usage: explore <location_name>")
+		}
+		location = cfg.trainer.CurrentLocation
+	} else {
+		locationName := args[0]
+
+		var err error
+		location, err = cfg.pokeapiClient.GetLocationByName(locationName)
+		if err != nil {
+			return err
+		}
+
+		if len(args) > 1 {
+			fmt.Printf("Additional arguments provided: %v\n", args[1:])
+		}
 	}
 
-	locationName := args[0]
-
-	location, err := cfg.pokeapiClient.GetLocationByName(locationName)
-
-	if err != nil {
-		return err
-	}
-	
 	fmt.Printf("Exploring %s...\n", location.Name)
 	fmt.Println("URL: ", location.URL)
-	
-	if len(args) > 1 {
-		fmt.Printf("Additional arguments provided: %v\n", args[1:])
-	}
 
 	areaResp, err := cfg.pokeapiClient.GetAreaDetails(location.URL)
 
@@ -36,6 +43,8 @@ func commandExplore(cfg *config, args ...string) error {
 	for _, pokemonEncounter := range areaResp.PokemonEncounters {
 		fmt.Printf("Pokemon: %s\n", pokemonEncounter.Pokemon.Name)
 	}
-	
+
+	cfg.trainer.CurrentLocation = location
+
 	return nil
 } 
\ No newline at end of file
@@ -8,6 +8,11 @@ func commandHelp(cfg *config, args ...string) error {
 	fmt.Println("help - Prints the help menu")
 	fmt.Println("exit - Exits the Pokedex")
 	fmt.Println("map - Get the next page of locations")
-	fmt.Println("explore <location_name> - Explore a specific location")
+	fmt.Println("mapb - Get the previous page of locations")
+	fmt.Println("explore [location_name] - Explore a specific location, or your current one if omitted")
+	fmt.Println("visit <location_area> - Set your current location area")
+	fmt.Println("catch <pokemon_name> - Throw a Pokeball at a Pokemon found in your current location")
+	fmt.Println("inspect <pokemon_name> - Inspect a Pokemon you've already caught")
+	fmt.Println("pokedex - List all the Pokemon you've caught")
 	return nil
 }
\ No newline at end of file
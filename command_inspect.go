@@ -0,0 +1,35 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+func commandInspect(cfg *config, args ...string) error {
+	if len(args) == 0 {
+		return errors.New("inspect command requires a pokemon name. Usage: inspect <pokemon_name>")
+	}
+
+	pokemonName := args[0]
+
+	pokemon, ok := cfg.trainer.Pokedex[pokemonName]
+	if !ok {
+		return fmt.Errorf("you have not caught %s", pokemonName)
+	}
+
+	fmt.Printf("Name: %s\n", pokemon.Name)
+	fmt.Printf("Height: %d\n", pokemon.Height)
+	fmt.Printf("Weight: %d\n", pokemon.Weight)
+
+	fmt.Println("Stats:")
+	for _, stat := range pokemon.Stats {
+		fmt.Printf("  - %s: %d\n", stat.Stat.Name, stat.BaseStat)
+	}
+
+	fmt.Println("Types:")
+	for _, pokemonType := range pokemon.Types {
+		fmt.Printf("  - %s\n", pokemonType.Type.Name)
+	}
+
+	return nil
+}
@@ -7,14 +7,14 @@ import "fmt"
 func commandMap(cfg *config, args ...string) error {
 	fmt.Println("Getting next page of locations")
 	
-	locationResp, err := cfg.pokeapiClient.ListLocations(cfg.nextLocationURL)
-	
+	locationResp, err := cfg.pokeapiClient.ListLocations(cfg.trainer.NextLocationURL)
+
 	if err != nil {
 		return err
 	}
 
-	cfg.nextLocationURL = &locationResp.Next
-	cfg.prevLocationURL = &locationResp.Previous
+	cfg.trainer.NextLocationURL = &locationResp.Next
+	cfg.trainer.PrevLocationURL = &locationResp.Previous
 
 	for _, location := range locationResp.Results {
 		fmt.Println(location.Name)
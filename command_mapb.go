@@ -0,0 +1,29 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+func commandMapb(cfg *config, args ...string) error {
+	if cfg.trainer.PrevLocationURL == nil || *cfg.trainer.PrevLocationURL == "" {
+		return errors.New("you're on the first page")
+	}
+
+	fmt.Println("Getting previous page of locations")
+
+	locationResp, err := cfg.pokeapiClient.ListLocations(cfg.trainer.PrevLocationURL)
+
+	if err != nil {
+		return err
+	}
+
+	cfg.trainer.NextLocationURL = &locationResp.Next
+	cfg.trainer.PrevLocationURL = &locationResp.Previous
+
+	for _, location := range locationResp.Results {
+		fmt.Println(location.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+func commandPokedex(cfg *config, args ...string) error {
+	if len(cfg.trainer.Pokedex) == 0 {
+		fmt.Println("Your Pokedex is empty. Go catch some Pokemon!")
+		return nil
+	}
+
+	names := make([]string, 0, len(cfg.trainer.Pokedex))
+	for name := range cfg.trainer.Pokedex {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Println("Your Pokedex:")
+	for _, name := range names {
+		fmt.Printf(" - %s\n", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+func commandVisit(cfg *config, args ...string) error {
+	if len(args) == 0 {
+		return errors.New("visit command requires a location area name. Usage: visit <location_area>")
+	}
+
+	locationName := args[0]
+
+	location, err := cfg.pokeapiClient.GetLocationByName(locationName)
+	if err != nil {
+		return err
+	}
+
+	cfg.trainer.CurrentLocation = location
+
+	fmt.Printf("You are now at %s\n", location.Name)
+
+	return nil
+}
@@ -4,19 +4,60 @@ import (
 	"net/http"
 	"time"
 
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+
 	"github.com/AskiaDev/go-pokedex/internal/pokecache"
 )
 
+const baseURL = "https://pokeapi.co/api/v2"
+
+// defaultRateLimit respects PokeAPI's fair-use guidance.
+const defaultRateLimit = 10 // requests per second
+
 type Client struct {
 	httpClient http.Client
 	cache      *pokecache.Cache
+	limiter    *rate.Limiter
+	group      *singleflight.Group
+}
+
+// ClientOption configures optional behavior on a Client built with
+// NewClientWithOptions.
+type ClientOption func(*Client)
+
+// WithRateLimit overrides the default PokeAPI request rate limit.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
 }
 
 func NewClient(timeout time.Duration, cache *pokecache.Cache) Client {
-	return Client{
+	return NewClientWithOptions(timeout, cache)
+}
+
+// NewClientWithOptions builds a Client with the given timeout and cache,
+// applying any ClientOptions over the defaults (a 10 req/s rate limit).
+func NewClientWithOptions(timeout time.Duration, cache *pokecache.Cache, opts ...ClientOption) Client {
+	c := Client{
 		httpClient: http.Client{
 			Timeout: timeout,
 		},
-		cache: cache,
+		cache:   cache,
+		limiter: rate.NewLimiter(rate.Limit(defaultRateLimit), defaultRateLimit),
+		group:   &singleflight.Group{},
+	}
+
+	for _, opt := range opts {
+		opt(&c)
 	}
-}
\ No newline at end of file
+
+	return c
+}
+
+// Cache returns the client's underlying cache, e.g. so callers can persist
+// it between sessions.
+func (c *Client) Cache() *pokecache.Cache {
+	return c.cache
+}
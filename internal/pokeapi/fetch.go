@@ -0,0 +1,113 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// APIError is returned when PokeAPI responds with a non-2xx status.
+type APIError struct {
+	StatusCode int
+	URL        string
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("pokeapi: %s returned %d: %s", e.URL, e.StatusCode, e.Body)
+}
+
+const (
+	fetchMaxAttempts = 3
+	fetchBaseDelay   = 200 * time.Millisecond
+)
+
+// fetch performs a GET against url, serving from cache when possible and
+// otherwise coalescing concurrent identical requests, rate limiting, and
+// retrying transient failures before unmarshaling the response into T.
+func fetch[T any](c *Client, url string) (T, error) {
+	var zero T
+
+	if cachedData, found := c.cache.Get(url); found {
+		var result T
+		if err := json.Unmarshal(cachedData, &result); err == nil {
+			return result, nil
+		}
+	}
+
+	data, err, _ := c.group.Do(url, func() (interface{}, error) {
+		return c.fetchAndCache(url)
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	var result T
+	if err := json.Unmarshal(data.([]byte), &result); err != nil {
+		return zero, err
+	}
+
+	return result, nil
+}
+
+// fetchAndCache issues the request, retrying transient failures with
+// exponential backoff and jitter, and caches the response body on success.
+func (c *Client) fetchAndCache(url string) ([]byte, error) {
+	var lastErr error
+
+	for attempt := 0; attempt < fetchMaxAttempts; attempt++ {
+		if attempt > 0 {
+			backoff := fetchBaseDelay * time.Duration(1<<uint(attempt-1))
+			jitter := time.Duration(rand.Int64N(int64(backoff)))
+			time.Sleep(backoff + jitter)
+		}
+
+		if err := c.limiter.Wait(context.Background()); err != nil {
+			return nil, err
+		}
+
+		data, retryable, err := c.doFetch(url)
+		if err == nil {
+			c.cache.Add(url, data)
+			return data, nil
+		}
+
+		lastErr = err
+		if !retryable {
+			return nil, err
+		}
+	}
+
+	return nil, lastErr
+}
+
+// doFetch performs a single GET attempt. retryable reports whether the
+// caller should retry on err (network errors and 5xx responses).
+func (c *Client) doFetch(url string) (data []byte, retryable bool, err error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, true, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &APIError{StatusCode: resp.StatusCode, URL: url, Body: string(body)}
+		return nil, resp.StatusCode >= 500, apiErr
+	}
+
+	return body, false, nil
+}
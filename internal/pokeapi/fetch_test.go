@@ -0,0 +1,133 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AskiaDev/go-pokedex/internal/pokecache"
+)
+
+type fetchTestPayload struct {
+	Value string `json:"value"`
+}
+
+func newTestClient() Client {
+	cache := pokecache.NewCache(time.Minute, 0)
+	return NewClientWithOptions(5*time.Second, cache, WithRateLimit(1000, 1000))
+}
+
+func TestFetchRetriesOn5xxThenSucceeds(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(fetchTestPayload{Value: "ok"})
+	}))
+	defer server.Close()
+
+	c := newTestClient()
+
+	result, err := fetch[fetchTestPayload](&c, server.URL)
+	if err != nil {
+		t.Fatalf("expected success, got error: %v", err)
+	}
+	if result.Value != "ok" {
+		t.Errorf("expected value %q, got %q", "ok", result.Value)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestFetchFailsAfterMaxAttemptsOn5xx(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := newTestClient()
+
+	_, err := fetch[fetchTestPayload](&c, server.URL)
+	if err == nil {
+		t.Fatal("expected error after exhausting retries")
+	}
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected status 500, got %d", apiErr.StatusCode)
+	}
+	if got := atomic.LoadInt32(&attempts); got != fetchMaxAttempts {
+		t.Errorf("expected %d attempts, got %d", fetchMaxAttempts, got)
+	}
+}
+
+func TestFetchDoesNotRetryOn4xx(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := newTestClient()
+
+	_, err := fetch[fetchTestPayload](&c, server.URL)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", apiErr.StatusCode)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("expected a single attempt for a 4xx, got %d", got)
+	}
+}
+
+func TestFetchCoalescesConcurrentRequests(t *testing.T) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		time.Sleep(50 * time.Millisecond)
+		json.NewEncoder(w).Encode(fetchTestPayload{Value: "ok"})
+	}))
+	defer server.Close()
+
+	c := newTestClient()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := fetch[fetchTestPayload](&c, server.URL); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("expected a single upstream request, got %d", got)
+	}
+}
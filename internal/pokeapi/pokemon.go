@@ -0,0 +1,7 @@
+package pokeapi
+
+func (c *Client) GetPokemon(name string) (Pokemon, error) {
+	url := baseURL + "/pokemon/" + name
+
+	return fetch[Pokemon](c, url)
+}
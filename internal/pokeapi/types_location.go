@@ -23,10 +23,3 @@ type AreaResponse struct {
 type PokemonEncounter struct {
 	Pokemon Pokemon `json:"pokemon"`
 }
-
-type Pokemon struct {
-	Name string `json:"name"`
-	URL  string `json:"url"`
-}
-
-
@@ -0,0 +1,27 @@
+package pokeapi
+
+type Pokemon struct {
+	Name           string        `json:"name"`
+	URL            string        `json:"url"`
+	BaseExperience int           `json:"base_experience"`
+	Height         int           `json:"height"`
+	Weight         int           `json:"weight"`
+	Stats          []PokemonStat `json:"stats"`
+	Types          []PokemonType `json:"types"`
+}
+
+type PokemonStat struct {
+	BaseStat int          `json:"base_stat"`
+	Effort   int          `json:"effort"`
+	Stat     NamedResource `json:"stat"`
+}
+
+type PokemonType struct {
+	Slot int          `json:"slot"`
+	Type NamedResource `json:"type"`
+}
+
+type NamedResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
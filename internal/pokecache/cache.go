@@ -1,25 +1,36 @@
 package pokecache
 
 import (
+	"container/list"
 	"sync"
 	"time"
 )
 
 type Cache struct {
-	cache map[string]cacheEntry
-	mu      *sync.Mutex
+	cache      map[string]*list.Element
+	order      *list.List
+	mu         *sync.Mutex
+	ttl        time.Duration
+	maxEntries int
 }
 
 type cacheEntry struct {
-	data []byte	
+	key       string
+	data      []byte
 	createdAt time.Time
 }
 
-func NewCache(interval time.Duration) *Cache {
+// NewCache creates a Cache that evicts entries older than ttl and, once it
+// holds more than maxEntries, evicts the least recently used entry to stay
+// within that bound. A maxEntries of 0 means unbounded.
+func NewCache(ttl time.Duration, maxEntries int) *Cache {
 	c := &Cache{
-		cache: make(map[string]cacheEntry),
-		mu: &sync.Mutex{},
+		cache:      make(map[string]*list.Element),
+		order:      list.New(),
+		mu:         &sync.Mutex{},
+		ttl:        ttl,
+		maxEntries: maxEntries,
 	}
-	go c.reapLoop(interval)
+	go c.reapLoop(ttl)
 	return c
-}
\ No newline at end of file
+}
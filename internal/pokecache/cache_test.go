@@ -8,7 +8,7 @@ import (
 
 
 func TestAdd(t *testing.T) {
-	cache := NewCache(8 * time.Second)
+	cache := NewCache(8*time.Second, 0)
 
 	cache.Add("test", []byte("test"))
 
@@ -20,7 +20,7 @@ func TestAdd(t *testing.T) {
 }
 
 func TestReap(t *testing.T) {
-	cache := NewCache(8 * time.Second)
+	cache := NewCache(8*time.Second, 0)
 
 	cache.Add("test", []byte("test"))
 
@@ -29,4 +29,26 @@ func TestReap(t *testing.T) {
 	if _, found := cache.Get("test"); found {
 		t.Errorf("Expected to not find key 'test'")
 	}
+}
+
+func TestLRUEviction(t *testing.T) {
+	cache := NewCache(time.Minute, 2)
+
+	cache.Add("a", []byte("a"))
+	cache.Add("b", []byte("b"))
+
+	// Touch "a" so "b" becomes the least recently used entry.
+	cache.Get("a")
+
+	cache.Add("c", []byte("c"))
+
+	if _, found := cache.Get("b"); found {
+		t.Errorf("Expected 'b' to have been evicted")
+	}
+	if _, found := cache.Get("a"); !found {
+		t.Errorf("Expected to find key 'a'")
+	}
+	if _, found := cache.Get("c"); !found {
+		t.Errorf("Expected to find key 'c'")
+	}
 }
\ No newline at end of file
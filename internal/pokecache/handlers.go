@@ -1,44 +1,72 @@
 package pokecache
 
 import (
-	"fmt"
 	"time"
 )
 
-// Add adds a new entry to the cache
-func (c *Cache) Add(key string, value []byte) error {
+// Add adds a new entry to the cache, overwriting any existing entry for
+// key, and evicts the least recently used entry if this would put the
+// cache over its maxEntries bound.
+func (c *Cache) Add(key string, value []byte) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	
-	if _, ok := c.cache[key]; ok {
-		return fmt.Errorf("key already exists")
+
+	c.addEntry(key, value, time.Now())
+}
+
+// addEntry inserts or refreshes key at the front of the LRU order. Callers
+// must hold c.mu.
+func (c *Cache) addEntry(key string, value []byte, createdAt time.Time) {
+	if elem, ok := c.cache[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.data = value
+		entry.createdAt = createdAt
+		c.order.MoveToFront(elem)
+		return
 	}
 
+	elem := c.order.PushFront(&cacheEntry{
+		key:       key,
+		data:      value,
+		createdAt: createdAt,
+	})
+	c.cache[key] = elem
 
-	c.cache[key] = cacheEntry{
-		data: value,
-		createdAt: time.Now(),
+	if c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		c.evictOldest()
 	}
-	return nil
 }
 
-// Get retrieves an entry from the cache
+// evictOldest removes the least recently used entry. Callers must hold c.mu.
+func (c *Cache) evictOldest() {
+	oldest := c.order.Back()
+	if oldest == nil {
+		return
+	}
+
+	c.order.Remove(oldest)
+	delete(c.cache, oldest.Value.(*cacheEntry).key)
+}
+
+// Get retrieves an entry from the cache and marks it as most recently used.
 func (c *Cache) Get(key string) ([]byte, bool) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	
-	entry, ok := c.cache[key]
+
+	elem, ok := c.cache[key]
 	if !ok {
 		return nil, false
 	}
-	return entry.data, true
+
+	c.order.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).data, true
 }
 
 // reapLoop is a loop that reap the cache every interval
 func (c *Cache) reapLoop(interval time.Duration) {
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
-	
+
 	for range ticker.C {
 		c.reap(interval)
 	}
@@ -48,9 +76,11 @@ func (c *Cache) reapLoop(interval time.Duration) {
 func (c *Cache) reap(interval time.Duration) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	
-	for key, entry := range c.cache {
+
+	for key, elem := range c.cache {
+		entry := elem.Value.(*cacheEntry)
 		if time.Since(entry.createdAt) > interval {
+			c.order.Remove(elem)
 			delete(c.cache, key)
 		}
 	}
@@ -0,0 +1,54 @@
+package pokecache
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type entrySnapshot struct {
+	Key       string    `json:"key"`
+	Data      []byte    `json:"data"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Snapshot serializes the cache's current entries, most recently used
+// first, so they can be persisted between sessions.
+func (c *Cache) Snapshot() ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries := make([]entrySnapshot, 0, len(c.cache))
+	for e := c.order.Front(); e != nil; e = e.Next() {
+		entry := e.Value.(*cacheEntry)
+		entries = append(entries, entrySnapshot{
+			Key:       entry.key,
+			Data:      entry.data,
+			CreatedAt: entry.createdAt,
+		})
+	}
+
+	return json.Marshal(entries)
+}
+
+// Restore repopulates the cache from a snapshot produced by Snapshot,
+// skipping entries that are already older than the cache's TTL and
+// respecting its maxEntries bound.
+func (c *Cache) Restore(data []byte) error {
+	var entries []entrySnapshot
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		if time.Since(entry.CreatedAt) > c.ttl {
+			continue
+		}
+		c.addEntry(entry.Key, entry.Data, entry.CreatedAt)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package pokecache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSnapshotRestoreRoundTrip(t *testing.T) {
+	cache := NewCache(time.Minute, 0)
+	cache.Add("fresh", []byte("fresh-data"))
+
+	data, err := cache.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot returned an error: %v", err)
+	}
+
+	restored := NewCache(time.Minute, 0)
+	if err := restored.Restore(data); err != nil {
+		t.Fatalf("Restore returned an error: %v", err)
+	}
+
+	value, found := restored.Get("fresh")
+	if !found {
+		t.Fatalf("expected to find key 'fresh' after restore")
+	}
+	if string(value) != "fresh-data" {
+		t.Errorf("expected 'fresh-data', got %q", value)
+	}
+}
+
+func TestRestoreSkipsExpiredEntries(t *testing.T) {
+	cache := NewCache(time.Minute, 0)
+	cache.addEntry("stale", []byte("stale-data"), time.Now().Add(-time.Hour))
+	cache.addEntry("fresh", []byte("fresh-data"), time.Now())
+
+	data, err := cache.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot returned an error: %v", err)
+	}
+
+	restored := NewCache(time.Minute, 0)
+	if err := restored.Restore(data); err != nil {
+		t.Fatalf("Restore returned an error: %v", err)
+	}
+
+	if _, found := restored.Get("stale"); found {
+		t.Errorf("expected 'stale' to be skipped as already expired")
+	}
+	if _, found := restored.Get("fresh"); !found {
+		t.Errorf("expected to find key 'fresh' after restore")
+	}
+}
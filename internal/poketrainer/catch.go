@@ -0,0 +1,28 @@
+package poketrainer
+
+import "math/rand/v2"
+
+const (
+	minCatchProbability            = 0.05
+	maxCatchProbability            = 0.95
+	catchProbabilityBaseExperience = 600.0
+)
+
+// CatchProbability returns the chance of successfully catching a Pokemon
+// with the given base experience, clamped to [0.05, 0.95].
+func CatchProbability(baseExperience int) float64 {
+	p := 1 - float64(baseExperience)/catchProbabilityBaseExperience
+
+	if p < minCatchProbability {
+		return minCatchProbability
+	}
+	if p > maxCatchProbability {
+		return maxCatchProbability
+	}
+	return p
+}
+
+// AttemptCatch rolls the dice on catching a Pokemon with the given base experience.
+func AttemptCatch(baseExperience int) bool {
+	return rand.Float64() < CatchProbability(baseExperience)
+}
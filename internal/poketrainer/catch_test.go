@@ -0,0 +1,22 @@
+package poketrainer
+
+import "testing"
+
+func TestCatchProbability(t *testing.T) {
+	cases := []struct {
+		baseExperience int
+		expected       float64
+	}{
+		{baseExperience: 0, expected: maxCatchProbability},
+		{baseExperience: 600, expected: minCatchProbability},
+		{baseExperience: 1000, expected: minCatchProbability},
+		{baseExperience: 300, expected: 0.5},
+	}
+
+	for _, c := range cases {
+		actual := CatchProbability(c.baseExperience)
+		if actual != c.expected {
+			t.Errorf("expected %v but got %v", c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package poketrainer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// Save persists the trainer's Pokedex to path as JSON, creating any
+// missing parent directories.
+func (t *Trainer) Save(path string) error {
+	data, err := json.Marshal(t.Pokedex)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Load restores the trainer's Pokedex from path. A missing file isn't an
+// error: it just means there's nothing to restore yet.
+func (t *Trainer) Load(path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, &t.Pokedex)
+}
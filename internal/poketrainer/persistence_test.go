@@ -0,0 +1,49 @@
+package poketrainer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/AskiaDev/go-pokedex/internal/pokeapi"
+)
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	trainer := New()
+	trainer.Pokedex["pikachu"] = pokeapi.Pokemon{
+		Name:           "pikachu",
+		BaseExperience: 112,
+		Height:         4,
+		Weight:         60,
+	}
+
+	path := filepath.Join(t.TempDir(), "nested", "pokedex.json")
+
+	if err := trainer.Save(path); err != nil {
+		t.Fatalf("Save returned an error: %v", err)
+	}
+
+	loaded := New()
+	if err := loaded.Load(path); err != nil {
+		t.Fatalf("Load returned an error: %v", err)
+	}
+
+	pokemon, ok := loaded.Pokedex["pikachu"]
+	if !ok {
+		t.Fatalf("expected to find pikachu in the loaded Pokedex")
+	}
+	if pokemon.BaseExperience != 112 {
+		t.Errorf("expected base experience 112, got %d", pokemon.BaseExperience)
+	}
+}
+
+func TestLoadMissingFileIsNotAnError(t *testing.T) {
+	trainer := New()
+	path := filepath.Join(t.TempDir(), "does-not-exist.json")
+
+	if err := trainer.Load(path); err != nil {
+		t.Errorf("expected no error loading a missing file, got %v", err)
+	}
+	if len(trainer.Pokedex) != 0 {
+		t.Errorf("expected an empty Pokedex, got %d entries", len(trainer.Pokedex))
+	}
+}
@@ -0,0 +1,20 @@
+package poketrainer
+
+import "github.com/AskiaDev/go-pokedex/internal/pokeapi"
+
+// Trainer tracks a player's progress: the location area they're currently
+// exploring, their position in the paginated location list, and the
+// Pokemon they've caught so far.
+type Trainer struct {
+	CurrentLocation *pokeapi.MapResult
+	NextLocationURL *string
+	PrevLocationURL *string
+	Pokedex         map[string]pokeapi.Pokemon
+}
+
+// New creates an empty Trainer with no current location and an empty Pokedex.
+func New() *Trainer {
+	return &Trainer{
+		Pokedex: make(map[string]pokeapi.Pokemon),
+	}
+}
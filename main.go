@@ -1,11 +1,15 @@
 package main
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/AskiaDev/go-pokedex/internal/pokeapi"
 	"github.com/AskiaDev/go-pokedex/internal/pokecache"
+	"github.com/AskiaDev/go-pokedex/internal/poketrainer"
 )
 
 
@@ -43,21 +47,97 @@ func getCommands() map[string]CliCommand {
 			description: "Explore a specific location",
 			callback:    commandExplore,
 		},
-		// "mapb": {
-		// 	name:        "mapb",
-		// 	description: "Get the previous page of locations",
-		// 	callback:    commandMapb,
-		// },
+		"visit": {
+			name:        "visit",
+			description: "Set your current location area",
+			callback:    commandVisit,
+		},
+		"catch": {
+			name:        "catch",
+			description: "Throw a Pokeball at a Pokemon found in your current location",
+			callback:    commandCatch,
+		},
+		"inspect": {
+			name:        "inspect",
+			description: "Inspect a Pokemon you've already caught",
+			callback:    commandInspect,
+		},
+		"pokedex": {
+			name:        "pokedex",
+			description: "List all the Pokemon you've caught",
+			callback:    commandPokedex,
+		},
+		"mapb": {
+			name:        "mapb",
+			description: "Get the previous page of locations",
+			callback:    commandMapb,
+		},
 	}
 }
 
 
 
+// dataDir returns the directory the Pokedex and cache are persisted under,
+// honoring $XDG_DATA_HOME.
+func dataDir() (string, error) {
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dataHome = filepath.Join(home, ".local", "share")
+	}
+	return filepath.Join(dataHome, "go-pokedex"), nil
+}
+
+func pokedexPath() (string, error) {
+	dir, err := dataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "pokedex.json"), nil
+}
+
+func cachePath() (string, error) {
+	dir, err := dataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "cache.json"), nil
+}
+
+// maxCacheEntries bounds how many paginated location, area, and pokemon
+// responses are kept in memory between reaps.
+const maxCacheEntries = 200
+
 func main() {
-	cache := pokecache.NewCache(5 * time.Minute)
+	cache := pokecache.NewCache(5*time.Minute, maxCacheEntries)
+
+	path, err := cachePath()
+	if err != nil {
+		fmt.Println("Warning: failed to resolve cache path:", err)
+	} else if data, err := os.ReadFile(path); err != nil && !os.IsNotExist(err) {
+		fmt.Println("Warning: failed to read cache:", err)
+	} else if err == nil {
+		if err := cache.Restore(data); err != nil {
+			fmt.Println("Warning: failed to restore cache:", err)
+		}
+	}
+
 	pokeClient := pokeapi.NewClient(5*time.Second, cache)
+
+	trainer := poketrainer.New()
+	path, err = pokedexPath()
+	if err != nil {
+		fmt.Println("Warning: failed to resolve pokedex path:", err)
+	} else if err := trainer.Load(path); err != nil {
+		fmt.Println("Warning: failed to load pokedex:", err)
+	}
+
 	cfg := &config{
 		pokeapiClient: pokeClient,
+		trainer:       trainer,
 	}
 	startRepl(cfg)
 }
\ No newline at end of file
@@ -6,13 +6,13 @@ import (
 	"os"
 
 	"github.com/AskiaDev/go-pokedex/internal/pokeapi"
+	"github.com/AskiaDev/go-pokedex/internal/poketrainer"
 )
 
 
 type config struct {
 	pokeapiClient pokeapi.Client
-	nextLocationURL *string
-	prevLocationURL *string
+	trainer *poketrainer.Trainer
 }
 
 func startRepl(cfg *config){